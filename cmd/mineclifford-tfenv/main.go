@@ -0,0 +1,52 @@
+// Command mineclifford-tfenv renders backend.tf and <env>.tfvars for the
+// config's cloud stack (terraform/aws or terraform/azure), plus <env>.tfvars
+// for the minecraft-server module, from a single project config, so those
+// directories never drift from each other.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/Saccilotto/Mineclifford-Server/internal/tfenv"
+)
+
+func main() {
+	configPath := flag.String("config", "mineclifford.yaml", "path to the project config")
+	env := flag.String("env", "dev", "environment name, used in the state key and tfvars filename")
+	create := flag.Bool("create", false, "create the state bucket/container if it doesn't already exist")
+	flag.Parse()
+
+	if err := run(*configPath, *env, *create); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(configPath, env string, create bool) error {
+	cfg, err := tfenv.LoadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	switch cfg.Cloud {
+	case "aws":
+		accountID, err := tfenv.EnsureAWSBackend(cfg, create)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("mineclifford-tfenv: using AWS account %s\n", accountID)
+	case "azure":
+		if err := tfenv.EnsureAzureBackend(context.Background(), cfg, cfg.SubscriptionID, create); err != nil {
+			return err
+		}
+	}
+
+	if err := tfenv.GenerateAll(cfg, env); err != nil {
+		return err
+	}
+
+	fmt.Printf("mineclifford-tfenv: rendered backend.tf/%s.tfvars for %s (%s)\n", env, cfg.Project, cfg.Cloud)
+	return nil
+}
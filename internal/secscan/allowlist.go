@@ -0,0 +1,52 @@
+// Package secscan loads the repo's .trivyignore-style allowlist so
+// TestMinecliffordSecurityPosture can waive specific, justified findings
+// without silently ignoring a whole severity class.
+package secscan
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Allowlist maps an AVD rule ID to the justification that followed it in
+// the ignore file.
+type Allowlist map[string]string
+
+// LoadAllowlist parses a .trivyignore-style file: one rule ID per line,
+// optionally followed by "# justification". Blank lines and full-line
+// comments are skipped.
+func LoadAllowlist(path string) (Allowlist, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("secscan: opening allowlist %s: %w", path, err)
+	}
+	defer f.Close()
+
+	allowlist := Allowlist{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		ruleID, justification, _ := strings.Cut(line, "#")
+		ruleID = strings.TrimSpace(ruleID)
+		justification = strings.TrimSpace(justification)
+		if ruleID == "" {
+			continue
+		}
+
+		allowlist[ruleID] = justification
+	}
+
+	return allowlist, scanner.Err()
+}
+
+// Allows reports whether ruleID has been explicitly waived.
+func (a Allowlist) Allows(ruleID string) bool {
+	_, ok := a[ruleID]
+	return ok
+}
@@ -0,0 +1,76 @@
+package tfenv
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// EnsureAWSBackend confirms cfg.StateBucket exists in the account reachable
+// with the caller's credentials and returns that account id for use in the
+// rendered backend block. With create set, a missing bucket is created with
+// versioning and server-side encryption enabled rather than failing.
+func EnsureAWSBackend(cfg *Config, create bool) (accountID string, err error) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(cfg.Region)})
+	if err != nil {
+		return "", fmt.Errorf("tfenv: creating AWS session: %w", err)
+	}
+
+	identity, err := sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", fmt.Errorf("tfenv: resolving AWS account via STS: %w", err)
+	}
+	accountID = aws.StringValue(identity.Account)
+
+	s3Client := s3.New(sess)
+	_, err = s3Client.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(cfg.StateBucket)})
+	if err == nil {
+		return accountID, nil
+	}
+	if !create {
+		return "", fmt.Errorf("tfenv: state bucket %s not found (pass --create to create it): %w", cfg.StateBucket, err)
+	}
+
+	createInput := &s3.CreateBucketInput{Bucket: aws.String(cfg.StateBucket)}
+	// us-east-1 is S3's default region and the one case where passing a
+	// LocationConstraint errors instead of being a no-op, so it's omitted.
+	if cfg.Region != "us-east-1" {
+		createInput.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(cfg.Region),
+		}
+	}
+	if _, err := s3Client.CreateBucket(createInput); err != nil {
+		return "", fmt.Errorf("tfenv: creating state bucket %s: %w", cfg.StateBucket, err)
+	}
+
+	_, err = s3Client.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket: aws.String(cfg.StateBucket),
+		VersioningConfiguration: &s3.VersioningConfiguration{
+			Status: aws.String(s3.BucketVersioningStatusEnabled),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("tfenv: enabling versioning on %s: %w", cfg.StateBucket, err)
+	}
+
+	_, err = s3Client.PutBucketEncryption(&s3.PutBucketEncryptionInput{
+		Bucket: aws.String(cfg.StateBucket),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: []*s3.ServerSideEncryptionRule{
+				{
+					ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+						SSEAlgorithm: aws.String(s3.ServerSideEncryptionAes256),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("tfenv: enabling encryption on %s: %w", cfg.StateBucket, err)
+	}
+
+	return accountID, nil
+}
@@ -0,0 +1,40 @@
+package tfenv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+)
+
+// EnsureAzureBackend confirms cfg.StateContainer exists in cfg.StateAccount.
+// With create set, a missing container is created rather than failing. The
+// storage account itself, like the resource group, is expected to already
+// exist; this package only manages the state container.
+func EnsureAzureBackend(ctx context.Context, cfg *Config, subscriptionID string, create bool) error {
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return fmt.Errorf("tfenv: creating Azure credential: %w", err)
+	}
+
+	client, err := armstorage.NewBlobContainersClient(subscriptionID, cred, nil)
+	if err != nil {
+		return fmt.Errorf("tfenv: creating Azure storage client: %w", err)
+	}
+
+	_, err = client.Get(ctx, cfg.ResourceGroup, cfg.StateAccount, cfg.StateContainer, nil)
+	if err == nil {
+		return nil
+	}
+	if !create {
+		return fmt.Errorf("tfenv: state container %s not found in account %s (pass --create to create it): %w", cfg.StateContainer, cfg.StateAccount, err)
+	}
+
+	_, err = client.Create(ctx, cfg.ResourceGroup, cfg.StateAccount, cfg.StateContainer, armstorage.BlobContainer{}, nil)
+	if err != nil {
+		return fmt.Errorf("tfenv: creating state container %s: %w", cfg.StateContainer, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Package tfenv generates per-environment Terraform backend and tfvars
+// files for Mineclifford from a single project config, so the AWS and
+// Azure stacks (and the minecraft-server module used directly in tests)
+// never have their backend/region/tags hand-copied between them.
+package tfenv
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the project-level config this package renders Terraform
+// environment files from. One Config produces one backend.tf +
+// <env>.tfvars pair per environment.
+type Config struct {
+	Project string            `yaml:"project"`
+	Cloud   string            `yaml:"cloud"` // "aws" or "azure"
+	Region  string            `yaml:"region"`
+	Tags    map[string]string `yaml:"tags"`
+
+	// AWS-only.
+	StateBucket string `yaml:"state_bucket"`
+
+	// Azure-only.
+	SubscriptionID string `yaml:"subscription_id"`
+	ResourceGroup  string `yaml:"resource_group"`
+	StateAccount   string `yaml:"state_account"`
+	StateContainer string `yaml:"state_container"`
+}
+
+// LoadConfig reads and validates a project config from path.
+func LoadConfig(path string) (*Config, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("tfenv: reading config %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("tfenv: parsing config %s: %w", path, err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("tfenv: %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+func (c *Config) validate() error {
+	if c.Project == "" {
+		return fmt.Errorf("project is required")
+	}
+
+	switch c.Cloud {
+	case "aws":
+		if c.StateBucket == "" {
+			return fmt.Errorf("state_bucket is required for cloud: aws")
+		}
+	case "azure":
+		if c.SubscriptionID == "" || c.ResourceGroup == "" || c.StateAccount == "" || c.StateContainer == "" {
+			return fmt.Errorf("subscription_id, resource_group, state_account and state_container are required for cloud: azure")
+		}
+	default:
+		return fmt.Errorf("cloud must be one of: aws, azure (got %q)", c.Cloud)
+	}
+
+	if c.Region == "" {
+		return fmt.Errorf("region is required")
+	}
+
+	return nil
+}
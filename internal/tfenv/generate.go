@@ -0,0 +1,28 @@
+package tfenv
+
+// TargetDirs are the Terraform directories GenerateAll can render into,
+// keyed by cloud ("aws", "azure") plus "module" for the shared
+// minecraft-server module.
+var TargetDirs = map[string]string{
+	"aws":    "terraform/aws",
+	"azure":  "terraform/azure",
+	"module": "terraform/modules/minecraft-server",
+}
+
+// GenerateAll renders backend.tf and <env>.tfvars for cfg's cloud into
+// that cloud's root stack, plus <env>.tfvars into the minecraft-server
+// module dir. It never touches the other cloud's root stack — rendering
+// an AWS config must not overwrite terraform/azure's backend.tf (child
+// modules can't have their own backend block, hence no RenderBackend call
+// for "module").
+func GenerateAll(cfg *Config, env string) error {
+	dir := TargetDirs[cfg.Cloud]
+	if err := RenderBackend(cfg, dir, env); err != nil {
+		return err
+	}
+	if err := RenderTfvars(cfg, dir, env); err != nil {
+		return err
+	}
+
+	return RenderTfvars(cfg, TargetDirs["module"], env)
+}
@@ -0,0 +1,95 @@
+package tfenv
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+var awsBackendTmpl = template.Must(template.New("aws-backend").Parse(`terraform {
+  backend "s3" {
+    bucket  = "{{.StateBucket}}"
+    key     = "{{.Project}}/{{.Env}}/terraform.tfstate"
+    region  = "{{.Region}}"
+    encrypt = true
+  }
+}
+`))
+
+var azureBackendTmpl = template.Must(template.New("azure-backend").Parse(`terraform {
+  backend "azurerm" {
+    resource_group_name  = "{{.ResourceGroup}}"
+    storage_account_name = "{{.StateAccount}}"
+    container_name       = "{{.StateContainer}}"
+    key                  = "{{.Project}}-{{.Env}}.tfstate"
+  }
+}
+`))
+
+var awsTfvarsTmpl = template.Must(template.New("aws-tfvars").Parse(`project_name = "{{.Project}}"
+region       = "{{.Region}}"
+{{- if .Tags}}
+tags = {
+{{- range $k, $v := .Tags}}
+  {{$k}} = "{{$v}}"
+{{- end}}
+}
+{{- end}}
+`))
+
+var azureTfvarsTmpl = template.Must(template.New("azure-tfvars").Parse(`project_name        = "{{.Project}}"
+location            = "{{.Region}}"
+resource_group_name = "{{.ResourceGroup}}"
+subscription_id     = "{{.SubscriptionID}}"
+{{- if .Tags}}
+tags = {
+{{- range $k, $v := .Tags}}
+  {{$k}} = "{{$v}}"
+{{- end}}
+}
+{{- end}}
+`))
+
+// RenderBackend writes backend.tf for cfg's cloud into dir. Child modules
+// (e.g. terraform/modules/minecraft-server) can't declare their own backend
+// block, so callers should only point this at root stacks.
+func RenderBackend(cfg *Config, dir, env string) error {
+	data := struct {
+		*Config
+		Env string
+	}{cfg, env}
+
+	tmpl := awsBackendTmpl
+	if cfg.Cloud == "azure" {
+		tmpl = azureBackendTmpl
+	}
+
+	return renderFile(tmpl, data, filepath.Join(dir, "backend.tf"))
+}
+
+// RenderTfvars writes a per-environment <env>.tfvars for cfg into dir.
+// The rendered variables match cfg.Cloud's stack: region/tags for AWS,
+// location/resource_group_name/subscription_id/tags for Azure.
+func RenderTfvars(cfg *Config, dir, env string) error {
+	tmpl := awsTfvarsTmpl
+	if cfg.Cloud == "azure" {
+		tmpl = azureTfvarsTmpl
+	}
+
+	return renderFile(tmpl, cfg, filepath.Join(dir, fmt.Sprintf("%s.tfvars", env)))
+}
+
+func renderFile(tmpl *template.Template, data interface{}, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("tfenv: creating %s: %w", path, err)
+	}
+	defer f.Close()
+
+	if err := tmpl.Execute(f, data); err != nil {
+		return fmt.Errorf("tfenv: rendering %s: %w", path, err)
+	}
+
+	return nil
+}
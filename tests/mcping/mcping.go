@@ -0,0 +1,137 @@
+// Package mcping implements the Minecraft Server List Ping handshake
+// (protocol 1.7+) so Terratest can confirm a freshly-applied server is
+// actually accepting connections, not just that its port is open.
+package mcping
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// Status is the subset of the JSON status response Terratest cares about.
+type Status struct {
+	Version struct {
+		Name     string `json:"name"`
+		Protocol int    `json:"protocol"`
+	} `json:"version"`
+	Players struct {
+		Max    int `json:"max"`
+		Online int `json:"online"`
+	} `json:"players"`
+	Description json.RawMessage `json:"description"`
+}
+
+// Ping performs the handshake + status request against host:port and
+// returns the parsed status response.
+func Ping(host string, port int, timeout time.Duration) (*Status, error) {
+	conn, err := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", host, port), timeout)
+	if err != nil {
+		return nil, fmt.Errorf("mcping: dial %s:%d: %w", host, port, err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+
+	if err := writeHandshake(conn, host, port); err != nil {
+		return nil, err
+	}
+	if err := writePacket(conn, []byte{0x00}); err != nil {
+		return nil, fmt.Errorf("mcping: writing status request: %w", err)
+	}
+
+	body, err := readPacket(conn)
+	if err != nil {
+		return nil, fmt.Errorf("mcping: reading status response: %w", err)
+	}
+
+	r := bytes.NewReader(body)
+	if _, err := readVarInt(r); err != nil { // packet id
+		return nil, fmt.Errorf("mcping: reading response packet id: %w", err)
+	}
+	jsonLen, err := readVarInt(r)
+	if err != nil {
+		return nil, fmt.Errorf("mcping: reading response length: %w", err)
+	}
+	raw := make([]byte, jsonLen)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		return nil, fmt.Errorf("mcping: reading response body: %w", err)
+	}
+
+	var status Status
+	if err := json.Unmarshal(raw, &status); err != nil {
+		return nil, fmt.Errorf("mcping: parsing status JSON: %w", err)
+	}
+
+	return &status, nil
+}
+
+func writeHandshake(conn net.Conn, host string, port int) error {
+	var body bytes.Buffer
+	body.WriteByte(0x00) // packet id
+	writeVarInt(&body, -1)
+	writeString(&body, host)
+	binary.Write(&body, binary.BigEndian, uint16(port))
+	writeVarInt(&body, 1) // next state: status
+
+	return writePacket(conn, body.Bytes())
+}
+
+func writePacket(conn net.Conn, body []byte) error {
+	var framed bytes.Buffer
+	writeVarInt(&framed, int32(len(body)))
+	framed.Write(body)
+	_, err := conn.Write(framed.Bytes())
+	return err
+}
+
+func readPacket(conn net.Conn) ([]byte, error) {
+	r := bufio.NewReader(conn)
+	length, err := readVarInt(r)
+	if err != nil {
+		return nil, err
+	}
+	body := make([]byte, length)
+	_, err = io.ReadFull(r, body)
+	return body, err
+}
+
+func writeVarInt(w *bytes.Buffer, value int32) {
+	v := uint32(value)
+	for {
+		if v&^0x7F == 0 {
+			w.WriteByte(byte(v))
+			return
+		}
+		w.WriteByte(byte(v&0x7F | 0x80))
+		v >>= 7
+	}
+}
+
+func writeString(w *bytes.Buffer, s string) {
+	writeVarInt(w, int32(len(s)))
+	w.WriteString(s)
+}
+
+func readVarInt(r io.ByteReader) (int32, error) {
+	var value int32
+	var position uint
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		value |= int32(b&0x7F) << position
+		if b&0x80 == 0 {
+			return value, nil
+		}
+		position += 7
+		if position >= 32 {
+			return 0, fmt.Errorf("mcping: VarInt is too big")
+		}
+	}
+}
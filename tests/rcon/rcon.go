@@ -0,0 +1,107 @@
+// Package rcon implements the minimal subset of the Source RCON protocol
+// (used by Minecraft's RCON server) needed by Terratest: authenticate and
+// run a single command.
+package rcon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+const (
+	packetTypeAuth         = 3
+	packetTypeAuthResponse = 2
+	packetTypeCommand      = 2
+	packetTypeResponse     = 0
+)
+
+// Client is a connected, authenticated RCON session.
+type Client struct {
+	conn      net.Conn
+	requestID int32
+}
+
+// Dial connects to address, authenticates with password, and returns a
+// ready-to-use Client.
+func Dial(address, password string, timeout time.Duration) (*Client, error) {
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("rcon: dial %s: %w", address, err)
+	}
+	conn.SetDeadline(time.Now().Add(timeout))
+
+	c := &Client{conn: conn, requestID: 1}
+	if err := c.send(packetTypeAuth, password); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("rcon: sending auth packet: %w", err)
+	}
+
+	id, _, err := c.read()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("rcon: reading auth response: %w", err)
+	}
+	if id == -1 {
+		conn.Close()
+		return nil, fmt.Errorf("rcon: authentication failed")
+	}
+
+	return c, nil
+}
+
+// Close closes the underlying connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Command sends cmd and returns the server's response body.
+func (c *Client) Command(cmd string) (string, error) {
+	if err := c.send(packetTypeCommand, cmd); err != nil {
+		return "", fmt.Errorf("rcon: sending command: %w", err)
+	}
+
+	_, body, err := c.read()
+	if err != nil {
+		return "", fmt.Errorf("rcon: reading command response: %w", err)
+	}
+
+	return body, nil
+}
+
+func (c *Client) send(packetType int32, payload string) error {
+	c.requestID++
+
+	var body bytes.Buffer
+	binary.Write(&body, binary.LittleEndian, c.requestID)
+	binary.Write(&body, binary.LittleEndian, packetType)
+	body.WriteString(payload)
+	body.Write([]byte{0x00, 0x00}) // payload + terminator null bytes
+
+	var packet bytes.Buffer
+	binary.Write(&packet, binary.LittleEndian, int32(body.Len()))
+	packet.Write(body.Bytes())
+
+	_, err := c.conn.Write(packet.Bytes())
+	return err
+}
+
+func (c *Client) read() (id int32, body string, err error) {
+	var size int32
+	if err := binary.Read(c.conn, binary.LittleEndian, &size); err != nil {
+		return 0, "", err
+	}
+
+	packet := make([]byte, size)
+	if _, err := io.ReadFull(c.conn, packet); err != nil {
+		return 0, "", err
+	}
+
+	id = int32(binary.LittleEndian.Uint32(packet[0:4]))
+	// packet[4:8] is the packet type, which callers don't need.
+	body = string(bytes.TrimRight(packet[8:], "\x00"))
+	return id, body, nil
+}
@@ -0,0 +1,61 @@
+//go:build e2e
+
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/retry"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saccilotto/Mineclifford-Server/tests/mcping"
+	"github.com/Saccilotto/Mineclifford-Server/tests/rcon"
+	"github.com/Saccilotto/Mineclifford-Server/tests/testhelper"
+)
+
+// TestMinecliffordAzureEndToEnd mirrors TestMinecliffordAWSEndToEnd against
+// the Azure stack.
+func TestMinecliffordAzureEndToEnd(t *testing.T) {
+	testhelper.RequireAzureCredentials(t)
+
+	opts := testhelper.Default(t, &terraform.Options{
+		TerraformDir: "../../terraform/azure",
+		Vars: map[string]interface{}{
+			"resource_group_name":  "mineclifford-e2e",
+			"server_names":         []string{"e2e-instance"},
+			"location":             testhelper.BestRegionAzure(t, "", nil),
+			"rcon_password":        testhelper.TestRCONPassword,
+			"admin_ssh_public_key": testhelper.TestSSHPublicKey,
+		},
+		NoColor: true,
+	})
+
+	defer terraform.Destroy(t, opts)
+	terraform.InitAndApplyAndIdempotent(t, opts)
+
+	host := terraform.Output(t, opts, "public_ip")
+	require.NotEmpty(t, host, "stack did not produce a public_ip output")
+
+	status := retry.DoWithRetry(t, "waiting for Minecraft server to accept connections", 30, 10*time.Second, func() (string, error) {
+		s, err := mcping.Ping(host, 25565, 5*time.Second)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s online=%d/%d", s.Version.Name, s.Players.Online, s.Players.Max), nil
+	})
+	t.Logf("mcping: %s", status)
+
+	if password := rconPassword(); password != "" {
+		client, err := rcon.Dial(fmt.Sprintf("%s:25575", host), password, 5*time.Second)
+		require.NoError(t, err)
+		defer client.Close()
+
+		response, err := client.Command("list")
+		require.NoError(t, err)
+		assert.Contains(t, response, "players online")
+	}
+}
@@ -0,0 +1,11 @@
+//go:build e2e
+
+package test
+
+import "os"
+
+// rconPassword returns the RCON password to authenticate the end-to-end
+// tests' optional RCON check with, or "" to skip that check entirely.
+func rconPassword() string {
+	return os.Getenv("RCON_PASSWORD")
+}
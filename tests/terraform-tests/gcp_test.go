@@ -0,0 +1,65 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Saccilotto/Mineclifford-Server/tests/testhelper"
+)
+
+// TestMinecliffordGCP tests the GCP Terraform configuration
+func TestMinecliffordGCP(t *testing.T) {
+	testhelper.RequireGCPCredentials(t)
+
+	// Terraform options for GCP
+	gcpOpts := testhelper.Default(t, &terraform.Options{
+		TerraformDir: "../../terraform/gcp",
+		Vars: map[string]interface{}{
+			"project_name":   "mineclifford-test",
+			"server_names":   []string{"test-instance"},
+			"gcp_project_id": testhelper.MustGCPProjectID(t),
+			"region":         "us-central1",
+			"zone":           "us-central1-a",
+		},
+		NoColor: true,
+	})
+
+	// Validate the Terraform configuration
+	terraform.InitAndValidate(t, gcpOpts)
+}
+
+// TestMinecliffordModuleGCP tests the Minecraft server module with the GCP
+// provider, including that project_name/zone/gcp_project_id flow through
+// and that server_names produces one instance per entry.
+func TestMinecliffordModuleGCP(t *testing.T) {
+	testhelper.RequireGCPCredentials(t)
+
+	moduleGCPOpts := testhelper.Default(t, &terraform.Options{
+		TerraformDir: "../../terraform/modules/minecraft-server",
+		Vars: map[string]interface{}{
+			"provider":       "gcp",
+			"project_name":   "mineclifford-module-test",
+			"server_names":   []string{"test-module-instance-1", "test-module-instance-2"},
+			"gcp_project_id": testhelper.MustGCPProjectID(t),
+			"region":         "us-central1",
+			"zone":           "us-central1-a",
+			"rcon_password":  testhelper.TestRCONPassword,
+		},
+		NoColor: true,
+	})
+
+	terraform.InitAndValidate(t, moduleGCPOpts)
+
+	// server_names has two entries; confirm both instances are planned.
+	planOutput := terraform.Plan(t, moduleGCPOpts)
+	assert.Contains(t, planOutput, "google_compute_instance.minecraft[0]")
+	assert.Contains(t, planOutput, "google_compute_instance.minecraft[1]")
+
+	// project_name (as namespaced by testhelper.Default), zone and
+	// gcp_project_id all reach the planned instances.
+	assert.Contains(t, planOutput, moduleGCPOpts.Vars["project_name"].(string)+"-test-module-instance-1")
+	assert.Contains(t, planOutput, `"us-central1-a"`)
+	assert.Contains(t, planOutput, `"`+moduleGCPOpts.Vars["gcp_project_id"].(string)+`"`)
+}
@@ -0,0 +1,202 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Saccilotto/Mineclifford-Server/tests/testhelper"
+)
+
+// TestMinecliffordAWS tests the AWS Terraform configuration
+func TestMinecliffordAWS(t *testing.T) {
+	testhelper.RequireAWSCredentials(t)
+
+	// Terraform options for AWS
+	awsOpts := testhelper.Default(t, &terraform.Options{
+		TerraformDir: "../../terraform/aws",
+		Vars: map[string]interface{}{
+			"project_name": "mineclifford-test",
+			"server_names": []string{"test-instance"},
+			"region":       testhelper.BestRegionAWS(t, "", nil),
+		},
+		NoColor: true,
+	})
+
+	// Validate the Terraform configuration
+	terraform.InitAndValidate(t, awsOpts)
+}
+
+// TestMinecliffordAzure tests the Azure Terraform configuration
+func TestMinecliffordAzure(t *testing.T) {
+	testhelper.RequireAzureCredentials(t)
+
+	// Terraform options for Azure
+	azureOpts := testhelper.Default(t, &terraform.Options{
+		TerraformDir: "../../terraform/azure",
+		Vars: map[string]interface{}{
+			"resource_group_name": "mineclifford-test",
+			"server_names":        []string{"test-instance"},
+			"location":            testhelper.BestRegionAzure(t, "", nil),
+		},
+		NoColor: true,
+	})
+
+	// Validate the Terraform configuration
+	terraform.InitAndValidate(t, azureOpts)
+}
+
+// TestMinecliffordModuleAWS tests the Minecraft server module with the AWS
+// provider via the module-aws fixture, which wires the module's
+// aws.us_east_1 configuration_aliases entry the same way terraform/aws
+// does. With region set to eu-west-1, the plan should still place the ACM
+// cert/Route53 health check on the us_east_1 provider config while the EC2
+// instance stays on the default, eu-west-1 one.
+func TestMinecliffordModuleAWS(t *testing.T) {
+	testhelper.RequireAWSCredentials(t)
+
+	moduleAWSOpts := testhelper.Default(t, &terraform.Options{
+		TerraformDir: "../fixtures/module-aws",
+		Vars: map[string]interface{}{
+			"project_name":  "mineclifford-module-test",
+			"server_names":  []string{"test-module-instance"},
+			"region":        "eu-west-1",
+			"domain_name":   "mc.example.com",
+			"rcon_password": testhelper.TestRCONPassword,
+		},
+		NoColor: true,
+	})
+
+	terraform.InitAndValidate(t, moduleAWSOpts)
+	plan := terraform.InitAndPlanAndShowWithStruct(t, moduleAWSOpts)
+
+	cert := findResource(t, &plan.RawPlan, "minecraft", "aws_acm_certificate", "dynmap")
+	healthCheck := findResource(t, &plan.RawPlan, "minecraft", "aws_route53_health_check", "minecraft")
+	instance := findResource(t, &plan.RawPlan, "minecraft", "aws_instance", "minecraft")
+
+	assert.Contains(t, cert.ProviderConfigKey, "us_east_1", "ACM cert should be planned against the us_east_1 provider alias")
+	assert.Contains(t, healthCheck.ProviderConfigKey, "us_east_1", "Route53 health check should be planned against the us_east_1 provider alias")
+	assert.NotContains(t, instance.ProviderConfigKey, "us_east_1", "EC2 instance should stay on the default (eu-west-1) provider, not us_east_1")
+}
+
+// TestMinecliffordModuleAzure tests the Minecraft server module with Azure provider
+func TestMinecliffordModuleAzure(t *testing.T) {
+	testhelper.RequireAzureCredentials(t)
+
+	// Terraform options for the module with Azure provider
+	moduleAzureOpts := testhelper.Default(t, &terraform.Options{
+		TerraformDir: "../../terraform/modules/minecraft-server",
+		Vars: map[string]interface{}{
+			"provider":            "azure",
+			"project_name":        "mineclifford-module-test",
+			"server_names":        []string{"test-module-instance"},
+			"location":            testhelper.BestRegionAzure(t, "", nil),
+			"resource_group_name": "mineclifford-module-test",
+			"subscription_id":     "00000000-0000-0000-0000-000000000000", // Dummy ID for validation
+		},
+		NoColor: true,
+	})
+
+	// Validate the Terraform configuration
+	terraform.InitAndValidate(t, moduleAzureOpts)
+}
+
+// TestMinecliffordTagging tests that resources have appropriate tags
+func TestMinecliffordTagging(t *testing.T) {
+	// Skip this test in CI pipelines with no AWS credentials
+	// This test can be run locally with proper AWS credentials
+	if testing.Short() {
+		t.Skip("Skipping tagging test in short mode")
+	}
+
+	t.Run("aws", func(t *testing.T) {
+		testhelper.RequireAWSCredentials(t)
+
+		// Terraform options for testing tags
+		taggingOpts := testhelper.Default(t, &terraform.Options{
+			TerraformDir: "../../terraform/modules/minecraft-server",
+			Vars: map[string]interface{}{
+				"provider":      "aws",
+				"project_name":  "mineclifford-tagging-test",
+				"server_names":  []string{"tagging-test"},
+				"rcon_password": testhelper.TestRCONPassword,
+				"tags": map[string]string{
+					"Environment": "test",
+					"Project":     "mineclifford",
+				},
+			},
+			NoColor: true,
+		})
+
+		// Initialize and validate the Terraform configuration
+		terraform.InitAndValidate(t, taggingOpts)
+
+		// Get output from terraform plan
+		planOutput := terraform.Plan(t, taggingOpts)
+
+		// Check that required tags are present in the plan output
+		assert.Contains(t, planOutput, `"Environment" = "test"`)
+		assert.Contains(t, planOutput, `"Project" = "mineclifford"`)
+	})
+
+	t.Run("gcp", func(t *testing.T) {
+		testhelper.RequireGCPCredentials(t)
+
+		// GCP labels are derived from the same var.tags, lowercased.
+		taggingOpts := testhelper.Default(t, &terraform.Options{
+			TerraformDir: "../../terraform/modules/minecraft-server",
+			Vars: map[string]interface{}{
+				"provider":       "gcp",
+				"project_name":   "mineclifford-tagging-test",
+				"server_names":   []string{"tagging-test"},
+				"gcp_project_id": testhelper.MustGCPProjectID(t),
+				"rcon_password":  testhelper.TestRCONPassword,
+				"tags": map[string]string{
+					"Environment": "Test",
+					"Project":     "Mineclifford",
+				},
+			},
+			NoColor: true,
+		})
+
+		terraform.InitAndValidate(t, taggingOpts)
+
+		planOutput := terraform.Plan(t, taggingOpts)
+
+		// GCP label keys and values must be lowercase.
+		assert.Contains(t, planOutput, `"environment" = "test"`)
+		assert.Contains(t, planOutput, `"project"     = "mineclifford"`)
+	})
+}
+
+// TestMinecliffordStateConsistency verifies that state files are generated correctly
+func TestMinecliffordStateConsistency(t *testing.T) {
+	// Skip this test in CI pipelines with no AWS/Azure credentials
+	if testing.Short() {
+		t.Skip("Skipping state consistency test in short mode")
+	}
+	testhelper.RequireAWSCredentials(t)
+
+	// Terraform options for AWS
+	stateOpts := testhelper.Default(t, &terraform.Options{
+		TerraformDir: "../../terraform/aws",
+		Vars: map[string]interface{}{
+			"project_name":  "mineclifford-state-test",
+			"server_names":  []string{"state-test"},
+			"rcon_password": testhelper.TestRCONPassword,
+		},
+		NoColor: true,
+	})
+
+	// Initialize Terraform
+	terraform.Init(t, stateOpts)
+
+	// Run terraform plan to generate the state file
+	terraform.RunTerraformCommand(t, stateOpts, "plan", "-out=terraform.tfplan")
+
+	// Check that state files are generated correctly
+	// Note: This is a simplified test, in a real scenario we would check for specific state contents
+	assert.FileExists(t, fmt.Sprintf("%s/.terraform", stateOpts.TerraformDir))
+}
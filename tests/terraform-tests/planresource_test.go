@@ -0,0 +1,29 @@
+package test
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/require"
+)
+
+// findResource locates a resource of type/name inside moduleCall's child
+// module in plan's configuration representation, failing the test if it's
+// missing.
+func findResource(t *testing.T, plan *tfjson.Plan, moduleCall, resourceType, resourceName string) *tfjson.ConfigResource {
+	require.NotNil(t, plan.Config, "plan has no configuration representation")
+	require.NotNil(t, plan.Config.RootModule, "plan configuration has no root module")
+
+	call, ok := plan.Config.RootModule.ModuleCalls[moduleCall]
+	require.True(t, ok, "root module does not call module %q", moduleCall)
+	require.NotNil(t, call.Module)
+
+	for _, resource := range call.Module.Resources {
+		if resource.Type == resourceType && resource.Name == resourceName {
+			return resource
+		}
+	}
+
+	t.Fatalf("module %q has no resource %s.%s", moduleCall, resourceType, resourceName)
+	return nil
+}
@@ -0,0 +1,306 @@
+package test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/require"
+)
+
+// providerRef identifies a provider configuration: its local name ("aws")
+// and, if present, its alias ("us_east_1").
+type providerRef struct {
+	name  string
+	alias string
+}
+
+func (p providerRef) String() string {
+	if p.alias == "" {
+		return p.name
+	}
+	return p.name + "." + p.alias
+}
+
+// dirWiring is everything TestMinecliffordProviderWiring needs out of one
+// Terraform directory to check its provider wiring, plus how its modules
+// connect to other scanned directories.
+type dirWiring struct {
+	dir string
+
+	// declared provider blocks: provider "x" { alias = "y" }
+	declared []providerRef
+	// configuration aliases a child module requires callers to pass
+	// through: required_providers { x = { configuration_aliases = [x.y] } }
+	configAliases []providerRef
+	// aliases referenced by `provider = x.y` on a resource/data block.
+	referenced []providerRef
+	// module "label" { source = "...", providers = { x = x, x.y = x.y } }
+	modules []moduleCall
+}
+
+type moduleCall struct {
+	label     string
+	source    string
+	providers map[providerRef]providerRef // callee alias -> caller alias
+}
+
+// rootStacks are scanned as root modules (they configure providers
+// directly); everything else in scannedDirs is a child module, which must
+// never declare its own non-aliased provider block.
+var rootStacks = map[string]bool{
+	"../../terraform/aws":   true,
+	"../../terraform/azure": true,
+	"../../terraform/gcp":   true,
+}
+
+// scannedDirs are the directories TestMinecliffordProviderWiring checks.
+var scannedDirs = []string{
+	"../../terraform/aws",
+	"../../terraform/azure",
+	"../../terraform/gcp",
+	"../../terraform/modules/minecraft-server",
+}
+
+// TestMinecliffordProviderWiring parses every .tf file in the AWS/Azure/GCP
+// root stacks and the minecraft-server module and fails when it finds a
+// provider wiring bug: a duplicate (name, alias) declaration, a resource
+// referencing an alias that's neither declared locally nor passed through
+// from a caller, or a child module shadowing the root's provider
+// configuration with its own non-aliased provider block.
+func TestMinecliffordProviderWiring(t *testing.T) {
+	wirings := map[string]*dirWiring{}
+	for _, dir := range scannedDirs {
+		w, err := scanDir(dir)
+		require.NoError(t, err, "scanning %s", dir)
+		wirings[dir] = w
+	}
+
+	var problems []string
+
+	for dir, w := range wirings {
+		problems = append(problems, checkDuplicates(dir, w)...)
+		problems = append(problems, checkReferences(dir, w, wirings)...)
+		if !rootStacks[dir] {
+			problems = append(problems, checkNoShadowing(dir, w)...)
+		}
+	}
+
+	if len(problems) > 0 {
+		t.Fatalf("found %d provider wiring problem(s):\n%s", len(problems), strings.Join(problems, "\n"))
+	}
+}
+
+func checkDuplicates(dir string, w *dirWiring) []string {
+	seen := map[providerRef]bool{}
+	var problems []string
+	for _, p := range w.declared {
+		if seen[p] {
+			problems = append(problems, fmt.Sprintf("%s: provider %q declared more than once", dir, p))
+		}
+		seen[p] = true
+	}
+	return problems
+}
+
+func checkNoShadowing(dir string, w *dirWiring) []string {
+	var problems []string
+	for _, p := range w.declared {
+		if p.alias == "" {
+			problems = append(problems, fmt.Sprintf(
+				"%s: child module declares its own non-aliased provider %q, which shadows the root's configuration",
+				dir, p.name,
+			))
+		}
+	}
+	return problems
+}
+
+func checkReferences(dir string, w *dirWiring, all map[string]*dirWiring) []string {
+	var problems []string
+
+	for _, ref := range w.referenced {
+		if ref.alias == "" {
+			continue // the default (non-aliased) provider is always inherited
+		}
+		if contains(w.declared, ref) {
+			continue
+		}
+		if !rootStacks[dir] && contains(w.configAliases, ref) {
+			// A child module can only reference its own configuration_aliases;
+			// whether a caller actually passes it through is checked below,
+			// from the caller's side, against every module that points here.
+			continue
+		}
+		problems = append(problems, fmt.Sprintf(
+			"%s: resource references provider %q, which is neither declared nor a configuration_alias",
+			dir, ref,
+		))
+	}
+
+	for _, mc := range w.modules {
+		target := resolveModuleDir(mc.source)
+		callee, ok := all[target]
+		if !ok {
+			continue // points somewhere we didn't scan
+		}
+		for _, required := range callee.configAliases {
+			if _, passed := mc.providers[required]; !passed {
+				problems = append(problems, fmt.Sprintf(
+					"%s: module %q doesn't pass through required provider alias %q (needs providers = { %s = ... })",
+					dir, mc.label, required, required,
+				))
+			}
+		}
+	}
+
+	return problems
+}
+
+func contains(refs []providerRef, ref providerRef) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveModuleDir maps a module block's relative `source` to the
+// directory key used in scannedDirs.
+func resolveModuleDir(source string) string {
+	switch {
+	case strings.Contains(source, "minecraft-server"):
+		return "../../terraform/modules/minecraft-server"
+	default:
+		return ""
+	}
+}
+
+func scanDir(dir string) (*dirWiring, error) {
+	w := &dirWiring{dir: dir}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := hclparse.NewParser()
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".tf") {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		f, diags := parser.ParseHCLFile(path)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("parsing %s: %w", path, diags)
+		}
+
+		body, ok := f.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			switch block.Type {
+			case "provider":
+				w.declared = append(w.declared, providerRef{name: block.Labels[0], alias: blockAlias(block)})
+			case "terraform":
+				w.configAliases = append(w.configAliases, requiredProviderAliases(block)...)
+			case "resource", "data":
+				if attr, ok := block.Body.Attributes["provider"]; ok {
+					if ref, ok := exprToProviderRef(attr.Expr); ok {
+						w.referenced = append(w.referenced, ref)
+					}
+				}
+			case "module":
+				mc := moduleCall{label: block.Labels[0], providers: map[providerRef]providerRef{}}
+				if attr, ok := block.Body.Attributes["source"]; ok {
+					val, diags := attr.Expr.Value(nil)
+					if !diags.HasErrors() && val.Type().FriendlyName() == "string" {
+						mc.source = val.AsString()
+					}
+				}
+				if attr, ok := block.Body.Attributes["providers"]; ok {
+					pairs, diags := hcl.ExprMap(attr.Expr)
+					if !diags.HasErrors() {
+						for _, pair := range pairs {
+							calleeRef, ok1 := exprToProviderRef(pair.Key)
+							callerRef, ok2 := exprToProviderRef(pair.Value)
+							if ok1 && ok2 {
+								mc.providers[calleeRef] = callerRef
+							}
+						}
+					}
+				}
+				w.modules = append(w.modules, mc)
+			}
+		}
+	}
+
+	return w, nil
+}
+
+func blockAlias(block *hclsyntax.Block) string {
+	attr, ok := block.Body.Attributes["alias"]
+	if !ok {
+		return ""
+	}
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() || val.Type().FriendlyName() != "string" {
+		return ""
+	}
+	return val.AsString()
+}
+
+func requiredProviderAliases(terraformBlock *hclsyntax.Block) []providerRef {
+	var aliases []providerRef
+	for _, inner := range terraformBlock.Body.Blocks {
+		if inner.Type != "required_providers" {
+			continue
+		}
+		for _, attr := range inner.Body.Attributes {
+			obj, diags := hcl.ExprMap(attr.Expr)
+			if diags.HasErrors() {
+				continue
+			}
+			for _, pair := range obj {
+				key, diags := pair.Key.Value(nil)
+				if diags.HasErrors() || key.AsString() != "configuration_aliases" {
+					continue
+				}
+				exprs, diags := hcl.ExprList(pair.Value)
+				if diags.HasErrors() {
+					continue
+				}
+				for _, e := range exprs {
+					if ref, ok := exprToProviderRef(e); ok {
+						aliases = append(aliases, ref)
+					}
+				}
+			}
+		}
+	}
+	return aliases
+}
+
+func exprToProviderRef(expr hcl.Expression) (providerRef, bool) {
+	traversal, diags := hcl.AbsTraversalForExpr(expr)
+	if diags.HasErrors() || len(traversal) == 0 {
+		return providerRef{}, false
+	}
+
+	ref := providerRef{name: traversal.RootName()}
+	if len(traversal) > 1 {
+		if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+			ref.alias = attr.Name
+		}
+	}
+	return ref, true
+}
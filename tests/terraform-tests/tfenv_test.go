@@ -0,0 +1,83 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saccilotto/Mineclifford-Server/internal/tfenv"
+)
+
+// TestMinecliffordTfenvAWS renders backend.tf/tfvars for an AWS config into
+// a copy of terraform/aws in a temp dir and proves the result is valid.
+func TestMinecliffordTfenvAWS(t *testing.T) {
+	dir := copyTerraformDir(t, "../../terraform/aws")
+
+	cfg := &tfenv.Config{
+		Project:     "mineclifford-tfenv-test",
+		Cloud:       "aws",
+		Region:      "us-east-2",
+		StateBucket: "mineclifford-tfenv-test-state",
+	}
+
+	require.NoError(t, tfenv.RenderBackend(cfg, dir, "test"))
+	require.NoError(t, tfenv.RenderTfvars(cfg, dir, "test"))
+
+	opts := &terraform.Options{
+		TerraformDir: dir,
+		VarFiles:     []string{"test.tfvars"},
+		NoColor:      true,
+	}
+	terraform.InitAndValidate(t, opts)
+}
+
+// TestMinecliffordTfenvAzure renders backend.tf/tfvars for an Azure config
+// into a copy of terraform/azure in a temp dir and proves the result is
+// valid.
+func TestMinecliffordTfenvAzure(t *testing.T) {
+	dir := copyTerraformDir(t, "../../terraform/azure")
+
+	cfg := &tfenv.Config{
+		Project:        "mineclifford-tfenv-test",
+		Cloud:          "azure",
+		Region:         "East US 2",
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		ResourceGroup:  "mineclifford-tfenv-test",
+		StateAccount:   "mineclifforstate",
+		StateContainer: "tfstate",
+	}
+
+	require.NoError(t, tfenv.RenderBackend(cfg, dir, "test"))
+	require.NoError(t, tfenv.RenderTfvars(cfg, dir, "test"))
+
+	opts := &terraform.Options{
+		TerraformDir: dir,
+		VarFiles:     []string{"test.tfvars"},
+		NoColor:      true,
+	}
+	terraform.InitAndValidate(t, opts)
+}
+
+// copyTerraformDir copies a Terraform directory into a fresh temp dir so
+// the generator can write backend.tf/tfvars without touching the repo's
+// real stacks, and registers cleanup.
+func copyTerraformDir(t *testing.T, src string) string {
+	dst := t.TempDir()
+
+	entries, err := os.ReadDir(src)
+	require.NoError(t, err)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(src, entry.Name()))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dst, entry.Name()), data, 0o644))
+	}
+
+	return dst
+}
@@ -0,0 +1,203 @@
+// Package testhelper provides shared Terratest scaffolding for the
+// Mineclifford test suite: namespacing so parallel runs don't collide,
+// region selection that routes around capacity/quota issues, and
+// environment validation with clear skips instead of opaque failures.
+package testhelper
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/terratest/modules/random"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+)
+
+// DefaultAWSRegions is the candidate list probed by BestRegionAWS when the
+// caller doesn't supply its own.
+var DefaultAWSRegions = []string{"us-east-2", "us-west-2", "us-east-1", "eu-west-1"}
+
+// DefaultAzureLocations is the candidate list probed by BestRegionAzure when
+// the caller doesn't supply its own.
+var DefaultAzureLocations = []string{"East US 2", "West US 2", "East US"}
+
+// defaultInstanceType is the EC2 instance type Mineclifford's AWS stack
+// provisions; BestRegionAWS checks offerings for this type unless the caller
+// passes a different one.
+const defaultInstanceType = "t3.medium"
+
+// defaultVMSize is the Azure VM size Mineclifford's Azure stack provisions;
+// BestRegionAzure checks availability for this size unless the caller
+// passes a different one.
+const defaultVMSize = "Standard_B2s"
+
+// TestRCONPassword is the rcon_password value Terratest runs pass to
+// satisfy the Mineclifford stacks/module's required variable of the same
+// name. Nothing asserts its value, so it's fixed rather than random.
+const TestRCONPassword = "mineclifford-test-rcon"
+
+// TestSSHPublicKey is a syntactically valid SSH public key Terratest runs
+// pass to satisfy terraform/azure and the module's required
+// admin_ssh_public_key variable. It isn't paired with a private key, so
+// it's only usable for plan/apply, never for actually logging in.
+const TestSSHPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDQnx2u4l3rOQvC9z8Wm2sVqzYkP8d5o7qF0cQ1E3c9xTestKeyNotForRealLoginYN9kq8bXwznBvQWJtZqF3p6s8Z test-runner@mineclifford"
+
+// Default namespaces opts so concurrent test runs don't collide: it appends
+// a short random suffix to project_name/resource_group_name (whichever is
+// set) and returns opts for chaining.
+func Default(t *testing.T, opts *terraform.Options) *terraform.Options {
+	suffix := random.UniqueId()
+
+	if name, ok := opts.Vars["project_name"].(string); ok {
+		opts.Vars["project_name"] = fmt.Sprintf("%s-%s", name, suffix)
+	}
+
+	if name, ok := opts.Vars["resource_group_name"].(string); ok {
+		opts.Vars["resource_group_name"] = fmt.Sprintf("%s-%s", name, suffix)
+	}
+
+	return opts
+}
+
+// RequireAWSCredentials skips the test with a clear message when the AWS
+// credentials Terratest needs aren't present in the environment.
+func RequireAWSCredentials(t *testing.T) {
+	requireEnv(t, "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY")
+}
+
+// RequireAzureCredentials skips the test with a clear message when the
+// Azure credentials/subscription Terratest needs aren't present in the
+// environment.
+func RequireAzureCredentials(t *testing.T) {
+	requireEnv(t, "AZURE_SUBSCRIPTION_ID", "AZURE_TENANT_ID", "AZURE_CLIENT_ID", "AZURE_CLIENT_SECRET")
+}
+
+// RequireGCPCredentials skips the test with a clear message when the GCP
+// credentials/project Terratest needs aren't present in the environment.
+func RequireGCPCredentials(t *testing.T) {
+	requireEnv(t, "GOOGLE_APPLICATION_CREDENTIALS", "GOOGLE_PROJECT")
+}
+
+// MustGCPProjectID returns GOOGLE_PROJECT, skipping the test if it's unset.
+// Call it after RequireGCPCredentials, which already validated it's present.
+func MustGCPProjectID(t *testing.T) string {
+	projectID := os.Getenv("GOOGLE_PROJECT")
+	if projectID == "" {
+		t.Skip("skipping: GOOGLE_PROJECT is not set")
+	}
+	return projectID
+}
+
+func requireEnv(t *testing.T, keys ...string) {
+	var missing []string
+	for _, key := range keys {
+		if os.Getenv(key) == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		t.Skipf("skipping: missing required environment variables %v", missing)
+	}
+}
+
+// BestRegionAWS returns the first region in candidates (DefaultAWSRegions if
+// candidates is empty) that reports an offering for instanceType (the
+// empty string defaults to defaultInstanceType). It falls back to the first
+// candidate if every probe fails, so callers always get a usable region.
+func BestRegionAWS(t *testing.T, instanceType string, candidates []string) string {
+	if instanceType == "" {
+		instanceType = defaultInstanceType
+	}
+	if len(candidates) == 0 {
+		candidates = DefaultAWSRegions
+	}
+
+	for _, region := range candidates {
+		sess, err := session.NewSession(&aws.Config{Region: aws.String(region)})
+		if err != nil {
+			continue
+		}
+
+		svc := ec2.New(sess)
+		out, err := svc.DescribeInstanceTypeOfferings(&ec2.DescribeInstanceTypeOfferingsInput{
+			LocationType: aws.String("region"),
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("instance-type"),
+					Values: []*string{aws.String(instanceType)},
+				},
+			},
+		})
+		if err != nil {
+			t.Logf("testhelper: region %s unavailable for %s: %v", region, instanceType, err)
+			continue
+		}
+		if len(out.InstanceTypeOfferings) > 0 {
+			return region
+		}
+	}
+
+	t.Logf("testhelper: no probed region confirmed %s, falling back to %s", instanceType, candidates[0])
+	return candidates[0]
+}
+
+// BestRegionAzure returns the first location in candidates (DefaultAzureLocations
+// if candidates is empty) that reports vmSize (the empty string defaults to
+// defaultVMSize) as an available size. It falls back to the first candidate
+// if every probe fails (including when AZURE_SUBSCRIPTION_ID isn't set or
+// credentials can't be resolved), so callers always get a usable location.
+func BestRegionAzure(t *testing.T, vmSize string, candidates []string) string {
+	if vmSize == "" {
+		vmSize = defaultVMSize
+	}
+	if len(candidates) == 0 {
+		candidates = DefaultAzureLocations
+	}
+
+	subscriptionID := os.Getenv("AZURE_SUBSCRIPTION_ID")
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if subscriptionID == "" || err != nil {
+		t.Logf("testhelper: can't probe Azure locations (subscription/credential unavailable: %v), falling back to %s", err, candidates[0])
+		return candidates[0]
+	}
+
+	client, err := armcompute.NewVirtualMachineSizesClient(subscriptionID, cred, nil)
+	if err != nil {
+		t.Logf("testhelper: creating Azure compute client: %v, falling back to %s", err, candidates[0])
+		return candidates[0]
+	}
+
+	for _, location := range candidates {
+		if azureLocationHasSize(t, client, location, vmSize) {
+			return location
+		}
+	}
+
+	t.Logf("testhelper: no probed location confirmed %s, falling back to %s", vmSize, candidates[0])
+	return candidates[0]
+}
+
+// azureLocationHasSize reports whether location offers vmSize, logging and
+// returning false (not failing the test) if the probe itself errors.
+func azureLocationHasSize(t *testing.T, client *armcompute.VirtualMachineSizesClient, location, vmSize string) bool {
+	pager := client.NewListPager(location, nil)
+	for pager.More() {
+		page, err := pager.NextPage(context.Background())
+		if err != nil {
+			t.Logf("testhelper: location %s unavailable for %s: %v", location, vmSize, err)
+			return false
+		}
+		for _, size := range page.Value {
+			if size.Name != nil && *size.Name == vmSize {
+				return true
+			}
+		}
+	}
+	return false
+}